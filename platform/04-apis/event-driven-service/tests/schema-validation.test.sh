@@ -14,7 +14,7 @@ NC='\033[0m' # No Color
 
 # Script directory and project root
 SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
-PROJECT_ROOT="$(cd "${SCRIPT_DIR}/../../.." && pwd)"
+PROJECT_ROOT="$(cd "${SCRIPT_DIR}/../../../.." && pwd)"
 
 # Paths
 VALIDATE_SCRIPT="${PROJECT_ROOT}/scripts/validate-claim.sh"
@@ -61,7 +61,7 @@ run_test() {
     local expected_result="$3"  # "pass" or "fail"
     local description="$4"
     
-    ((TESTS_RUN++))
+    TESTS_RUN=$((TESTS_RUN + 1))
     
     echo "----------------------------------------"
     echo -e "${BLUE}Test ${TESTS_RUN}:${NC} ${test_name}"
@@ -94,7 +94,7 @@ run_test() {
     # Report result
     if [[ "${test_passed}" == true ]]; then
         echo -e "${GREEN}✓ PASSED${NC}"
-        ((TESTS_PASSED++))
+        TESTS_PASSED=$((TESTS_PASSED + 1))
     else
         echo -e "${RED}✗ FAILED${NC}"
         echo ""
@@ -102,7 +102,7 @@ run_test() {
         echo ""
         echo "Output:"
         echo "${OUTPUT}"
-        ((TESTS_FAILED++))
+        TESTS_FAILED=$((TESTS_FAILED + 1))
         FAILED_TESTS+=("${test_name}")
     fi
     
@@ -137,6 +137,48 @@ run_test \
     "fail" \
     "Validates that required field nats.stream must be present"
 
+# Test 5: Unregistered target cluster
+run_test \
+    "Unregistered Target Cluster" \
+    "invalid-cluster.yaml" \
+    "fail" \
+    "Validates that cluster only accepts registered workload clusters (no admin ProviderConfigs)"
+
+# Test 6: Valid API permissions
+run_test \
+    "Valid API Permissions" \
+    "valid-permissions.yaml" \
+    "pass" \
+    "Validates that explicit permissions for the core (\"\") and named API groups are accepted"
+
+# Test 7: Wildcard permission resources
+run_test \
+    "Wildcard Permission Resources" \
+    "invalid-permissions-wildcard-resources.yaml" \
+    "fail" \
+    "Validates that permissions resources reject wildcards (not in the allowlist)"
+
+# Test 8: Wildcard permission API groups
+run_test \
+    "Wildcard Permission API Groups" \
+    "invalid-permissions-wildcard-api-groups.yaml" \
+    "fail" \
+    "Validates that permissions apiGroups reject wildcards"
+
+# Test 9: Empty permissions
+run_test \
+    "Empty Permissions" \
+    "invalid-permissions-empty.yaml" \
+    "fail" \
+    "Validates that permissions contains at least one rule when set"
+
+# Test 10: Permission resource outside the allowlist
+run_test \
+    "Disallowed Permission Resource" \
+    "invalid-permissions-secrets.yaml" \
+    "fail" \
+    "Validates that permissions resources only accept the platform allowlist (no secrets)"
+
 # Summary
 echo "=================================================="
 echo "Test Suite Summary"
@@ -16,12 +16,12 @@ NC='\033[0m' # No Color
 
 # Script directory and project root
 SCRIPT_DIR="$(cd "$(dirname "${BASH_SOURCE[0]}")" && pwd)"
-PROJECT_ROOT="$(cd "${SCRIPT_DIR}/../../.." && pwd)"
+PROJECT_ROOT="$(cd "${SCRIPT_DIR}/../../../.." && pwd)"
 
 # Test configuration
 TEST_NAMESPACE="test-eds-full-$(date +%s)"
 CLAIM_NAME="full-featured-worker"
-CLAIM_FILE="${PROJECT_ROOT}/platform/04-apis/examples/full-claim.yaml"
+CLAIM_FILE="${PROJECT_ROOT}/platform/04-apis/event-driven-service/examples/full-claim.yaml"
 TIMEOUT_SECONDS=300
 POLL_INTERVAL=5
 
@@ -167,7 +167,7 @@ fi
 # Check if EventDrivenService CRD exists
 if ! kubectl get crd eventdrivenservices.platform.bizmatters.io &>/dev/null; then
     echo -e "${RED}ERROR: EventDrivenService CRD not installed${NC}"
-    echo "Please apply the XRD first: kubectl apply -f platform/04-apis/definitions/xeventdrivenservices.yaml"
+    echo "Please apply the XRD first: kubectl apply -f platform/04-apis/event-driven-service/definitions/xeventdrivenservices.yaml"
     exit 1
 fi
 
@@ -6,7 +6,7 @@
 # 1. platform-apis Application exists and is synced
 # 2. EventDrivenService XRD (CRD) is installed
 # 3. event-driven-service Composition exists
-# 4. Schema file published at platform/04-apis/schemas/
+# 4. Schema file published at platform/04-apis/event-driven-service/schemas/
 
 # Print output immediately for CI visibility
 echo "Starting EventDrivenService API verification..."
@@ -129,7 +129,7 @@ if kubectl_retry get crd xeventdrivenservices.platform.bizmatters.io &>/dev/null
     fi
 else
     echo -e "${RED}✗ XRD 'xeventdrivenservices.platform.bizmatters.io' not found${NC}"
-    echo -e "${BLUE}ℹ  Check if platform/04-apis/definitions/xeventdrivenservices.yaml is applied${NC}"
+    echo -e "${BLUE}ℹ  Check if platform/04-apis/event-driven-service/definitions/xeventdrivenservices.yaml is applied${NC}"
     ERRORS=$((ERRORS + 1))
 fi
 
@@ -243,9 +243,9 @@ if [ $ERRORS -eq 0 ] && [ $WARNINGS -eq 0 ]; then
     echo -e "${GREEN}✓ All checks passed! EventDrivenService API is ready.${NC}"
     echo ""
     echo -e "${BLUE}ℹ  Next steps:${NC}"
-    echo "  - Validate example claims: ./scripts/validate-claim.sh $REPO_ROOT/platform/04-apis/examples/minimal-claim.yaml"
-    echo "  - Test deployment: kubectl apply -f $REPO_ROOT/platform/04-apis/examples/minimal-claim.yaml"
-    echo "  - Run composition tests: $REPO_ROOT/platform/04-apis/tests/verify-composition.sh"
+    echo "  - Validate example claims: ./scripts/validate-claim.sh $REPO_ROOT/platform/04-apis/event-driven-service/examples/minimal-claim.yaml"
+    echo "  - Test deployment: kubectl apply -f $REPO_ROOT/platform/04-apis/event-driven-service/examples/minimal-claim.yaml"
+    echo "  - Run composition tests: $REPO_ROOT/platform/04-apis/event-driven-service/tests/verify-composition.sh"
     exit 0
 elif [ $ERRORS -eq 0 ]; then
     echo -e "${YELLOW}⚠️  EventDrivenService API has $WARNINGS warning(s) but no errors${NC}"